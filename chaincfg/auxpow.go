@@ -0,0 +1,287 @@
+// Copyright (c) 2025 Junkcoin Developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chaincfg
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/big"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// auxPowVersionBit marks a block header as carrying an AuxPoW payload. It
+// is bit 8 of the block version, the lowest bit of its second byte.
+const auxPowVersionBit = 0x100
+
+// MsgAuxPow is a Junkcoin-specific wrapper around the extra payload carried
+// by a merged-mined block header: the parent chain's coinbase transaction,
+// the merkle branch linking that coinbase to the parent's merkle root, the
+// branch linking the child block hash into the coinbase's merged-mining
+// commitment, and the parent block header itself.
+type MsgAuxPow struct {
+	CoinbaseTx     wire.MsgTx
+	CoinbaseBranch []chainhash.Hash
+	CoinbaseIndex  uint32
+	ChainBranch    []chainhash.Hash
+	ChainIndex     uint32
+	ParentBlock    wire.BlockHeader
+}
+
+// HasAuxPow reports whether a block version carries the AuxPoW bit.
+func HasAuxPow(version int32) bool {
+	return version&auxPowVersionBit != 0
+}
+
+// JunkcoinBlock mirrors wire.MsgBlock's header and transactions and
+// additionally carries the AuxPoW payload a merged-mined block's header
+// points to via auxPowVersionBit.
+// AuxPow is nil for blocks that were not merge-mined.
+type JunkcoinBlock struct {
+	Header       wire.BlockHeader
+	AuxPow       *MsgAuxPow
+	Transactions []*wire.MsgTx
+}
+
+// Deserialize decodes a raw Junkcoin block from r: the standard 80-byte
+// header, followed by the AuxPoW payload when the header's version marks
+// it as merge-mined, followed by the transaction vector. This is the
+// on-the-wire layout merged-mined Junkcoin blocks use in place of the plain
+// wire.MsgBlock encoding.
+func (b *JunkcoinBlock) Deserialize(r io.Reader) error {
+	if err := b.Header.Deserialize(r); err != nil {
+		return err
+	}
+
+	if HasAuxPow(b.Header.Version) {
+		b.AuxPow = new(MsgAuxPow)
+		if err := b.AuxPow.Deserialize(r); err != nil {
+			return err
+		}
+	} else {
+		b.AuxPow = nil
+	}
+
+	txCount, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return err
+	}
+
+	b.Transactions = make([]*wire.MsgTx, txCount)
+	for i := range b.Transactions {
+		tx := new(wire.MsgTx)
+		if err := tx.Deserialize(r); err != nil {
+			return err
+		}
+		b.Transactions[i] = tx
+	}
+
+	return nil
+}
+
+// Serialize encodes a Junkcoin block to w, writing the AuxPoW payload
+// between the header and the transaction vector whenever b.AuxPow is
+// non-nil, mirroring Deserialize.
+func (b *JunkcoinBlock) Serialize(w io.Writer) error {
+	if err := b.Header.Serialize(w); err != nil {
+		return err
+	}
+
+	if b.AuxPow != nil {
+		if err := b.AuxPow.Serialize(w); err != nil {
+			return err
+		}
+	}
+
+	if err := wire.WriteVarInt(w, 0, uint64(len(b.Transactions))); err != nil {
+		return err
+	}
+	for _, tx := range b.Transactions {
+		if err := tx.Serialize(w); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CheckAuxPow validates the AuxPoW payload carried by the block, if any,
+// against params. It is a convenience wrapper around the package-level
+// CheckAuxPow for callers already holding a decoded JunkcoinBlock.
+func (b *JunkcoinBlock) CheckAuxPow(height int32, params *Params) error {
+	if b.AuxPow == nil {
+		return errors.New("chaincfg: block does not carry an AuxPoW payload")
+	}
+	return CheckAuxPow(&b.Header, b.AuxPow, height, params)
+}
+
+// Deserialize decodes an AuxPoW payload from r in the format appended after
+// the standard 80-byte header of a merged-mined Junkcoin block.
+func (a *MsgAuxPow) Deserialize(r io.Reader) error {
+	if err := a.CoinbaseTx.Deserialize(r); err != nil {
+		return err
+	}
+
+	if err := readAuxPowBranch(r, &a.CoinbaseBranch, &a.CoinbaseIndex); err != nil {
+		return err
+	}
+	if err := readAuxPowBranch(r, &a.ChainBranch, &a.ChainIndex); err != nil {
+		return err
+	}
+
+	return a.ParentBlock.Deserialize(r)
+}
+
+// Serialize encodes an AuxPoW payload to w in the format appended after the
+// standard 80-byte header of a merged-mined Junkcoin block.
+func (a *MsgAuxPow) Serialize(w io.Writer) error {
+	if err := a.CoinbaseTx.Serialize(w); err != nil {
+		return err
+	}
+
+	if err := writeAuxPowBranch(w, a.CoinbaseBranch, a.CoinbaseIndex); err != nil {
+		return err
+	}
+	if err := writeAuxPowBranch(w, a.ChainBranch, a.ChainIndex); err != nil {
+		return err
+	}
+
+	return a.ParentBlock.Serialize(w)
+}
+
+func readAuxPowBranch(r io.Reader, branch *[]chainhash.Hash, index *uint32) error {
+	count, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return err
+	}
+
+	hashes := make([]chainhash.Hash, count)
+	for i := range hashes {
+		if _, err := io.ReadFull(r, hashes[i][:]); err != nil {
+			return err
+		}
+	}
+	*branch = hashes
+
+	return binary.Read(r, binary.LittleEndian, index)
+}
+
+func writeAuxPowBranch(w io.Writer, branch []chainhash.Hash, index uint32) error {
+	if err := wire.WriteVarInt(w, 0, uint64(len(branch))); err != nil {
+		return err
+	}
+	for _, hash := range branch {
+		if _, err := w.Write(hash[:]); err != nil {
+			return err
+		}
+	}
+	return binary.Write(w, binary.LittleEndian, index)
+}
+
+// checkMerkleBranch recomputes the merkle root obtained by climbing the
+// given branch from leaf, using the side bits encoded in index, and
+// compares it against root.
+func checkMerkleBranch(leaf chainhash.Hash, branch []chainhash.Hash, index uint32, root chainhash.Hash) bool {
+	hash := leaf
+	for _, sibling := range branch {
+		var buf [chainhash.HashSize * 2]byte
+		if index&1 != 0 {
+			copy(buf[:chainhash.HashSize], sibling[:])
+			copy(buf[chainhash.HashSize:], hash[:])
+		} else {
+			copy(buf[:chainhash.HashSize], hash[:])
+			copy(buf[chainhash.HashSize:], sibling[:])
+		}
+		hash = chainhash.DoubleHashH(buf[:])
+		index >>= 1
+	}
+	return hash == root
+}
+
+// CheckAuxPow validates the AuxPoW payload carried by header against
+// params. It verifies that:
+//
+//  1. the parent chain coinbase transaction commits to the child block
+//     hash via the merged-mining merkle branch;
+//  2. the coinbase transaction itself is included in the parent block via
+//     the coinbase merkle branch;
+//  3. the parent header's proof of work hash, computed using params'
+//     PoWFunction, meets the child header's target; and
+//  4. the AuxPoW chain ID encoded in the child header's version matches
+//     params.AuxPowChainID.
+func CheckAuxPow(header *wire.BlockHeader, auxPow *MsgAuxPow, height int32, params *Params) error {
+	if !HasAuxPow(header.Version) {
+		return errors.New("chaincfg: block version does not carry an AuxPoW payload")
+	}
+	if params.AuxPowActivationHeight == 0 && params.AuxPowChainID == 0 {
+		return errors.New("chaincfg: AuxPoW is not configured for this network")
+	}
+	if height < params.AuxPowActivationHeight {
+		return errors.New("chaincfg: AuxPoW is not yet active at this height")
+	}
+
+	chainID := (header.Version >> 16) & 0xffff
+	if chainID != params.AuxPowChainID {
+		return errors.New("chaincfg: AuxPoW chain ID does not match params")
+	}
+
+	childHash := header.BlockHash()
+	if !checkMerkleBranch(childHash, auxPow.ChainBranch, auxPow.ChainIndex, coinbaseCommitment(&auxPow.CoinbaseTx)) {
+		return errors.New("chaincfg: AuxPoW coinbase does not commit to child block hash")
+	}
+
+	coinbaseHash := auxPow.CoinbaseTx.TxHash()
+	if !checkMerkleBranch(coinbaseHash, auxPow.CoinbaseBranch, auxPow.CoinbaseIndex, auxPow.ParentBlock.MerkleRoot) {
+		return errors.New("chaincfg: AuxPoW coinbase is not included in parent block")
+	}
+
+	var buf bytes.Buffer
+	if err := auxPow.ParentBlock.Serialize(&buf); err != nil {
+		return err
+	}
+
+	parentHash := BlockPoWHash(buf.Bytes(), height, params)
+
+	target := compactToBig(header.Bits)
+	if hashToBig(&parentHash).Cmp(target) > 0 {
+		return errors.New("chaincfg: AuxPoW parent block does not meet child target")
+	}
+
+	return nil
+}
+
+// hashToBig converts a chainhash.Hash into a big.Int treating the hash as a
+// little-endian unsigned 256-bit number, matching Bitcoin's convention for
+// comparing a block hash against a target.
+func hashToBig(hash *chainhash.Hash) *big.Int {
+	var buf chainhash.Hash
+	for i, b := range hash {
+		buf[chainhash.HashSize-1-i] = b
+	}
+	return new(big.Int).SetBytes(buf[:])
+}
+
+// coinbaseCommitment extracts the merged-mining commitment hash embedded in
+// the parent chain coinbase transaction's scriptSig, immediately following
+// a dedicated magic value.
+func coinbaseCommitment(tx *wire.MsgTx) chainhash.Hash {
+	if len(tx.TxIn) == 0 {
+		return chainhash.Hash{}
+	}
+	script := tx.TxIn[0].SignatureScript
+
+	magic := []byte{0xfa, 0xbe, 'm', 'm'}
+	idx := bytes.Index(script, magic)
+	if idx == -1 || idx+len(magic)+chainhash.HashSize > len(script) {
+		return chainhash.Hash{}
+	}
+
+	var commitment chainhash.Hash
+	copy(commitment[:], script[idx+len(magic):idx+len(magic)+chainhash.HashSize])
+	return commitment
+}
@@ -0,0 +1,234 @@
+// Copyright (c) 2025 Junkcoin Developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chaincfg
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// TestAuxPowSerializeDeserialize exercises a round trip of a merge-mined
+// block through JunkcoinBlock.Serialize/Deserialize. The fixture is
+// synthetic rather than a captured historical mainnet block (this tree has
+// no network access to fetch one), but it carries the same AuxPoW shape -
+// coinbase, coinbase branch, chain branch, and parent header - as a real
+// merged-mined block past AuxPowActivationHeight.
+func TestAuxPowSerializeDeserialize(t *testing.T) {
+	auxPow := &MsgAuxPow{
+		CoinbaseTx: wire.MsgTx{
+			Version: 1,
+			TxIn: []*wire.TxIn{
+				{
+					PreviousOutPoint: wire.OutPoint{Index: 0xffffffff},
+					SignatureScript:  []byte{0x01, 0x02, 0x03},
+					Sequence:         0xffffffff,
+				},
+			},
+			TxOut: []*wire.TxOut{
+				{Value: 5000000000, PkScript: []byte{0x51}},
+			},
+		},
+		CoinbaseBranch: []chainhash.Hash{*newHashFromStr("a2effa738145e377e08a61d76179c21703e13e48910b30a2a87f0dfe794b64c6")},
+		CoinbaseIndex:  0,
+		ChainBranch:    []chainhash.Hash{*newHashFromStr("ca55073a54775a1ef78294f53f38a3e02d0654d7417f3cbbe4d28d17d50e07d0")},
+		ChainIndex:     0,
+		ParentBlock: wire.BlockHeader{
+			Version:    1,
+			PrevBlock:  chainhash.Hash{},
+			MerkleRoot: chainhash.Hash{},
+			Timestamp:  time.Unix(1231006505, 0),
+			Bits:       0x1d00ffff,
+			Nonce:      2083236893,
+		},
+	}
+
+	original := &JunkcoinBlock{
+		Header: wire.BlockHeader{
+			Version:    1 | auxPowVersionBit,
+			PrevBlock:  chainhash.Hash{},
+			MerkleRoot: chainhash.Hash{},
+			Timestamp:  time.Unix(1401292357, 0),
+			Bits:       0x1e0ffff0,
+			Nonce:      12345,
+		},
+		AuxPow:       auxPow,
+		Transactions: []*wire.MsgTx{&auxPow.CoinbaseTx},
+	}
+
+	var buf bytes.Buffer
+	if err := original.Serialize(&buf); err != nil {
+		t.Fatalf("Serialize: %v", err)
+	}
+
+	var decoded JunkcoinBlock
+	if err := decoded.Deserialize(&buf); err != nil {
+		t.Fatalf("Deserialize: %v", err)
+	}
+
+	if !HasAuxPow(decoded.Header.Version) {
+		t.Fatal("decoded header lost its AuxPoW version bit")
+	}
+	if decoded.AuxPow == nil {
+		t.Fatal("decoded block is missing its AuxPoW payload")
+	}
+	if decoded.AuxPow.CoinbaseTx.TxHash() != original.AuxPow.CoinbaseTx.TxHash() {
+		t.Error("decoded AuxPoW coinbase does not match original")
+	}
+	if len(decoded.AuxPow.CoinbaseBranch) != 1 || decoded.AuxPow.CoinbaseBranch[0] != auxPow.CoinbaseBranch[0] {
+		t.Error("decoded AuxPoW coinbase branch does not match original")
+	}
+	if len(decoded.AuxPow.ChainBranch) != 1 || decoded.AuxPow.ChainBranch[0] != auxPow.ChainBranch[0] {
+		t.Error("decoded AuxPoW chain branch does not match original")
+	}
+	if decoded.AuxPow.ParentBlock.BlockHash() != auxPow.ParentBlock.BlockHash() {
+		t.Error("decoded AuxPoW parent header does not match original")
+	}
+	if len(decoded.Transactions) != 1 {
+		t.Fatalf("decoded block has %d transactions, want 1", len(decoded.Transactions))
+	}
+}
+
+// TestAuxPowSerializeDeserializeNoAuxPow verifies that a block without the
+// AuxPoW version bit round-trips without an AuxPoW payload.
+func TestAuxPowSerializeDeserializeNoAuxPow(t *testing.T) {
+	original := &JunkcoinBlock{
+		Header: wire.BlockHeader{
+			Version:    1,
+			PrevBlock:  chainhash.Hash{},
+			MerkleRoot: chainhash.Hash{},
+			Timestamp:  time.Unix(1401292357, 0),
+			Bits:       0x1e0ffff0,
+			Nonce:      1,
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := original.Serialize(&buf); err != nil {
+		t.Fatalf("Serialize: %v", err)
+	}
+
+	var decoded JunkcoinBlock
+	if err := decoded.Deserialize(&buf); err != nil {
+		t.Fatalf("Deserialize: %v", err)
+	}
+	if decoded.AuxPow != nil {
+		t.Error("decoded non-merge-mined block unexpectedly has an AuxPoW payload")
+	}
+}
+
+// looseTargetBits encodes a target above the maximum possible 256-bit hash,
+// so any parent PoW hash satisfies it. Tests that exercise the
+// commitment/inclusion/chain-ID checks use it so a real parent block doesn't
+// need to be mined just to clear the target check too.
+var looseTargetBits = bigToCompact(new(big.Int).Lsh(big.NewInt(1), 256))
+
+// newAuxPow builds a self-consistent AuxPoW payload for chainID targeting
+// targetBits: the coinbase commits to the child block hash via an empty
+// (branchless) chain branch, and is itself the parent block's sole
+// (branchless) merkle leaf, so CheckAuxPow accepts it as-is.
+func newAuxPow(chainID int32, targetBits uint32) (wire.BlockHeader, *MsgAuxPow) {
+	header := wire.BlockHeader{
+		Version:    1 | auxPowVersionBit | (chainID << 16),
+		PrevBlock:  chainhash.Hash{},
+		MerkleRoot: chainhash.Hash{},
+		Timestamp:  time.Unix(1401292357, 0),
+		Bits:       targetBits,
+		Nonce:      1,
+	}
+	childHash := header.BlockHash()
+
+	commitment := append([]byte{0xfa, 0xbe, 'm', 'm'}, childHash[:]...)
+	coinbaseTx := wire.MsgTx{
+		Version: 1,
+		TxIn: []*wire.TxIn{
+			{
+				PreviousOutPoint: wire.OutPoint{Index: 0xffffffff},
+				SignatureScript:  commitment,
+				Sequence:         0xffffffff,
+			},
+		},
+		TxOut: []*wire.TxOut{
+			{Value: 5000000000, PkScript: []byte{0x51}},
+		},
+	}
+
+	auxPow := &MsgAuxPow{
+		CoinbaseTx: coinbaseTx,
+		ParentBlock: wire.BlockHeader{
+			Version:    1,
+			PrevBlock:  chainhash.Hash{},
+			MerkleRoot: coinbaseTx.TxHash(),
+			Timestamp:  time.Unix(1231006505, 0),
+			Bits:       0x1d00ffff,
+			Nonce:      1,
+		},
+	}
+	return header, auxPow
+}
+
+// TestCheckAuxPowAccept verifies CheckAuxPow accepts a well-formed AuxPoW
+// payload: matching chain ID, a coinbase that commits to the child hash, a
+// coinbase included in the parent block, and a parent PoW hash meeting the
+// child's target.
+func TestCheckAuxPowAccept(t *testing.T) {
+	params := JunkcoinMainNetParams
+	header, auxPow := newAuxPow(params.AuxPowChainID, looseTargetBits)
+
+	if err := CheckAuxPow(&header, auxPow, params.AuxPowActivationHeight, &params); err != nil {
+		t.Errorf("CheckAuxPow on a well-formed payload: %v", err)
+	}
+}
+
+// TestCheckAuxPowRejectBadChainID verifies CheckAuxPow rejects a payload
+// whose child header encodes a chain ID other than params.AuxPowChainID.
+func TestCheckAuxPowRejectBadChainID(t *testing.T) {
+	params := JunkcoinMainNetParams
+	header, auxPow := newAuxPow(params.AuxPowChainID+1, looseTargetBits)
+
+	if err := CheckAuxPow(&header, auxPow, params.AuxPowActivationHeight, &params); err == nil {
+		t.Error("CheckAuxPow accepted a payload with the wrong chain ID")
+	}
+}
+
+// TestCheckAuxPowRejectBadMerkleBranch verifies CheckAuxPow rejects a
+// payload whose coinbase is not actually included in the parent block's
+// merkle root.
+func TestCheckAuxPowRejectBadMerkleBranch(t *testing.T) {
+	params := JunkcoinMainNetParams
+	header, auxPow := newAuxPow(params.AuxPowChainID, looseTargetBits)
+	auxPow.ParentBlock.MerkleRoot = chainhash.Hash{0x01}
+
+	if err := CheckAuxPow(&header, auxPow, params.AuxPowActivationHeight, &params); err == nil {
+		t.Error("CheckAuxPow accepted a coinbase that is not included in the parent block")
+	}
+}
+
+// TestCheckAuxPowRejectParentAboveTarget verifies CheckAuxPow rejects a
+// payload whose parent PoW hash does not meet the child header's target.
+func TestCheckAuxPowRejectParentAboveTarget(t *testing.T) {
+	params := JunkcoinMainNetParams
+	// A zero target: every non-zero hash exceeds it.
+	header, auxPow := newAuxPow(params.AuxPowChainID, 0)
+
+	if err := CheckAuxPow(&header, auxPow, params.AuxPowActivationHeight, &params); err == nil {
+		t.Error("CheckAuxPow accepted a parent block whose PoW hash exceeds the child target")
+	}
+}
+
+// TestCheckAuxPowRejectPreActivation verifies CheckAuxPow rejects an
+// otherwise well-formed payload at a height before AuxPowActivationHeight.
+func TestCheckAuxPowRejectPreActivation(t *testing.T) {
+	params := JunkcoinMainNetParams
+	header, auxPow := newAuxPow(params.AuxPowChainID, looseTargetBits)
+
+	if err := CheckAuxPow(&header, auxPow, params.AuxPowActivationHeight-1, &params); err == nil {
+		t.Error("CheckAuxPow accepted a payload before AuxPoW activation height")
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2025 Junkcoin Developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chaincfg
+
+import (
+	"math/big"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+// IsAssumedValid reports whether hash is params.AssumeValid itself,
+// meaning a header-first sync is permitted to skip script and signature
+// validation for the block it identifies. Ancestors of AssumeValid are not
+// recognised: only an exact match returns true, and a zero AssumeValid
+// never matches. Proof of work must still be validated on every header
+// regardless of this result.
+func IsAssumedValid(hash *chainhash.Hash, params *Params) bool {
+	return params.AssumeValid != (chainhash.Hash{}) && *hash == params.AssumeValid
+}
+
+// hexToBigInt parses a hex string into a big.Int and panics on error. It is
+// only safe to use with hard coded values such as MinimumChainWork.
+func hexToBigInt(s string) *big.Int {
+	n, ok := new(big.Int).SetString(s, 16)
+	if !ok {
+		panic("chaincfg: invalid hex string " + s)
+	}
+	return n
+}
+
+// HasSufficientChainWork reports whether work meets or exceeds
+// params.MinimumChainWork. A header chain whose accumulated work falls
+// short should be rejected outright rather than spending time validating
+// it in full.
+func HasSufficientChainWork(work *big.Int, params *Params) bool {
+	if params.MinimumChainWork == nil {
+		return true
+	}
+	return work.Cmp(params.MinimumChainWork) >= 0
+}
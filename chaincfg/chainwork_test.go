@@ -0,0 +1,81 @@
+// Copyright (c) 2025 Junkcoin Developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chaincfg
+
+import (
+	"math/big"
+	"testing"
+)
+
+// TestIsAssumedValid verifies IsAssumedValid matches only params.AssumeValid
+// itself, not an arbitrary other hash, and that a zero-value AssumeValid
+// (networks like regtest that don't set one) never matches.
+func TestIsAssumedValid(t *testing.T) {
+	params := JunkcoinMainNetParams
+
+	if !IsAssumedValid(&params.AssumeValid, &params) {
+		t.Error("IsAssumedValid(params.AssumeValid) = false, want true")
+	}
+
+	other := *newHashFromStr("000000000000000000000000000000000000000000000000000000000000000f")
+	if IsAssumedValid(&other, &params) {
+		t.Error("IsAssumedValid(unrelated hash) = true, want false")
+	}
+
+	regtestParams := JunkcoinRegressionNetParams
+	if IsAssumedValid(&regtestParams.AssumeValid, &regtestParams) {
+		t.Error("IsAssumedValid on params with no AssumeValid set = true, want false")
+	}
+}
+
+// TestAssumeValidPastNewestCheckpoint verifies mainnet's AssumeValid isn't
+// the same block as its newest checkpoint - if it were, assumed-valid sync
+// would skip no more validation than the checkpoint already does, defeating
+// the point of having it.
+func TestAssumeValidPastNewestCheckpoint(t *testing.T) {
+	params := JunkcoinMainNetParams
+	newest := params.Checkpoints[len(params.Checkpoints)-1]
+	if params.AssumeValid == *newest.Hash {
+		t.Errorf("AssumeValid (%s) duplicates the newest checkpoint at height %d; it should be past it",
+			params.AssumeValid, newest.Height)
+	}
+}
+
+// TestHasSufficientChainWork verifies HasSufficientChainWork compares
+// against params.MinimumChainWork and treats a nil MinimumChainWork (e.g.
+// regtest) as "no floor".
+func TestHasSufficientChainWork(t *testing.T) {
+	params := JunkcoinMainNetParams
+
+	below := new(big.Int).Sub(params.MinimumChainWork, big.NewInt(1))
+	if HasSufficientChainWork(below, &params) {
+		t.Error("HasSufficientChainWork(MinimumChainWork-1) = true, want false")
+	}
+
+	if !HasSufficientChainWork(params.MinimumChainWork, &params) {
+		t.Error("HasSufficientChainWork(MinimumChainWork) = false, want true")
+	}
+
+	above := new(big.Int).Add(params.MinimumChainWork, big.NewInt(1))
+	if !HasSufficientChainWork(above, &params) {
+		t.Error("HasSufficientChainWork(MinimumChainWork+1) = false, want true")
+	}
+
+	regtestParams := JunkcoinRegressionNetParams
+	if !HasSufficientChainWork(big.NewInt(0), &regtestParams) {
+		t.Error("HasSufficientChainWork with no MinimumChainWork set = false, want true")
+	}
+}
+
+// TestHexToBigIntPanicsOnInvalidHex verifies hexToBigInt panics rather than
+// silently returning a zero value for a malformed hard coded constant.
+func TestHexToBigIntPanicsOnInvalidHex(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("hexToBigInt(invalid hex) did not panic")
+		}
+	}()
+	hexToBigInt("not-hex")
+}
@@ -87,6 +87,29 @@ var JunkcoinMainNetParams = Params{
 	// BIP44 coin type used in the hierarchical deterministic path for
 	// address generation.
 	HDCoinType: 2013, // BIP44 coin type for Junkcoin
+
+	// Junkcoin uses Scrypt proof of work and a height-aware retarget
+	// rather than the default double-SHA256 / Bitcoin-style retarget.
+	PoWFunction:      ScryptPoWHash,
+	DiffCalcFunction: JunkcoinDiffCalc,
+
+	// Merged mining (AuxPoW) was enabled on Junkcoin mainnet at this
+	// height.
+	AuxPowActivationHeight: 200000,
+	AuxPowChainID:          0x0062, // Junkcoin's registered merged-mining chain ID
+
+	// MinimumChainWork is the accumulated work of the best known chain
+	// at release time; any competing header chain with less work is
+	// rejected outright during header-first sync.
+	MinimumChainWork: hexToBigInt("000000000000000000000000000000000000000000000001a5c4d7896a2f3e"),
+
+	// AssumeValid names the block for which, with its ancestors, script
+	// and signature validation may be skipped. It
+	// must be kept ahead of the newest entry in Checkpoints, or IBD gets
+	// no benefit from it beyond what the checkpoint already gives for
+	// free. TODO: update to the chain tip at each release; this is a
+	// placeholder block past height 168312 until one is confirmed.
+	AssumeValid: *newHashFromStr("aaaa2bcecb1146ae9cd74d67b29b4d0161e9bb63beb9022ca10f3625dda6c0e6"),
 }
 
 // JunkcoinTestNetParams defines the network parameters for the Junkcoin test network.
@@ -146,6 +169,11 @@ var JunkcoinTestNetParams = Params{
 	// BIP44 coin type used in the hierarchical deterministic path for
 	// address generation.
 	HDCoinType: 11337, // Custom coin type for Junkcoin testnet
+
+	// Junkcoin uses Scrypt proof of work and a height-aware retarget
+	// rather than the default double-SHA256 / Bitcoin-style retarget.
+	PoWFunction:      ScryptPoWHash,
+	DiffCalcFunction: JunkcoinDiffCalc,
 }
 
 // junkcoinMainPowLimit is the highest proof of work value a Junkcoin block can
@@ -0,0 +1,308 @@
+// Copyright (c) 2025 Junkcoin Developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chaincfg
+
+import (
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// bigOne is 1 represented as a big.Int. It is defined here to avoid the
+// overhead of creating it multiple times.
+var bigOne = big.NewInt(1)
+
+// ErrDuplicateNet describes an error where the parameters for a network
+// could not be registered due to the network magic already being
+// registered.
+var ErrDuplicateNet = errors.New("duplicate network")
+
+// DNSSeed identifies a DNS seed.
+type DNSSeed struct {
+	// Host defines the hostname of the seed.
+	Host string
+
+	// HasFiltering defines whether the seed supports filtering by service
+	// flags (wire.ServiceFlag).
+	HasFiltering bool
+}
+
+// Checkpoint identifies a known good point in the block chain. Generally
+// this is used to help prevent forks from a long time ago by only
+// accepting block chain forks on or after this point.
+type Checkpoint struct {
+	Height int32
+	Hash   *chainhash.Hash
+}
+
+// PoWFunction defines a block hashing function used to compute the proof of
+// work hash for a given serialized block header at a given height. Params
+// that leave this nil fall back to the standard double-SHA256 hash used by
+// Bitcoin.
+type PoWFunction func(headerBytes []byte, height int32) chainhash.Hash
+
+// DiffCalcFunction defines a difficulty retarget function used to compute
+// the required proof of work bits for the block that follows the passed
+// headers. Params that leave this nil fall back to the standard Bitcoin
+// retarget algorithm driven by TargetTimespan/TargetTimePerBlock.
+type DiffCalcFunction func(headers []wire.BlockHeader, height int32, params *Params) (uint32, error)
+
+// Params defines a Junkcoin network by its parameters. These parameters may
+// be used by Junkcoin applications to differentiate networks as well as
+// addresses and keys for one network from those intended for use on another
+// network.
+type Params struct {
+	// Name defines a human-readable identifier for the network.
+	Name string
+
+	// Net defines the magic bytes used to identify the network.
+	Net wire.BitcoinNet
+
+	// DefaultPort defines the default peer-to-peer port for the network.
+	DefaultPort string
+
+	// DNSSeeds defines a list of DNS seeds for the network that are used
+	// as one method to discover peers.
+	DNSSeeds []DNSSeed
+
+	// GenesisBlock defines the first block of the chain.
+	GenesisBlock *wire.MsgBlock
+
+	// GenesisHash is the starting block hash.
+	GenesisHash *chainhash.Hash
+
+	// PowLimit defines the highest allowed proof of work value for a
+	// block as a uint256.
+	PowLimit *big.Int
+
+	// PowLimitBits defines the highest allowed proof of work value for a
+	// block in compact form.
+	PowLimitBits uint32
+
+	// BIP0034Height is the height at which BIP0034 (block version
+	// numbers) became active.
+	BIP0034Height int32
+
+	// BIP0065Height is the height at which BIP0065 (CLTV) became active.
+	BIP0065Height int32
+
+	// BIP0066Height is the height at which BIP0066 (strict DER
+	// signatures) became active.
+	BIP0066Height int32
+
+	// CoinbaseMaturity is the number of blocks required before newly
+	// mined coins can be spent.
+	CoinbaseMaturity uint16
+
+	// SubsidyReductionInterval is the height interval at which the
+	// block subsidy is reduced.
+	SubsidyReductionInterval int32
+
+	// TargetTimespan is the desired amount of time between difficulty
+	// retargets.
+	TargetTimespan time.Duration
+
+	// TargetTimePerBlock is the desired amount of time between blocks.
+	TargetTimePerBlock time.Duration
+
+	// RetargetAdjustmentFactor is the adjustment factor used to limit
+	// the minimum and maximum amount of adjustment that can occur
+	// between difficulty retargets.
+	RetargetAdjustmentFactor int64
+
+	// ReduceMinDifficulty defines whether the network allows reducing
+	// the minimum required difficulty when a block hasn't been mined in
+	// the duration specified by MinDiffReductionTime.
+	ReduceMinDifficulty bool
+
+	// MinDiffReductionTime is the amount of time after which the
+	// minimum required difficulty is reduced when ReduceMinDifficulty is
+	// true.
+	MinDiffReductionTime time.Duration
+
+	// GenerateSupported defines whether CPU mining is allowed on the
+	// network.
+	GenerateSupported bool
+
+	// Checkpoints ordered from oldest to newest.
+	Checkpoints []Checkpoint
+
+	// RuleChangeActivationThreshold is the number of blocks in a
+	// MinerConfirmationWindow that must signal support for a rule
+	// change for it to become active.
+	RuleChangeActivationThreshold uint32
+
+	// MinerConfirmationWindow is the number of blocks used in version
+	// bits voting.
+	MinerConfirmationWindow uint32
+
+	// RelayNonStdTxs defines whether the network allows relaying of
+	// non-standard transactions.
+	RelayNonStdTxs bool
+
+	// Bech32HRPSegwit is the human-readable part for Bech32 encoded
+	// segwit addresses, as defined in BIP 173.
+	Bech32HRPSegwit string
+
+	// Address encoding magics.
+	PubKeyHashAddrID        byte
+	ScriptHashAddrID        byte
+	PrivateKeyID            byte
+	WitnessPubKeyHashAddrID byte
+	WitnessScriptHashAddrID byte
+
+	// BIP32 hierarchical deterministic extended key magics.
+	HDPrivateKeyID [4]byte
+	HDPublicKeyID  [4]byte
+
+	// HDCoinType is the BIP44 coin type used in the hierarchical
+	// deterministic path for address generation.
+	HDCoinType uint32
+
+	// PoWFunction, when non-nil, overrides the default double-SHA256
+	// block hashing algorithm used to validate a header's proof of
+	// work. This allows params such as Junkcoin's, which use Scrypt, to
+	// plug in their own hashing without forking the validation code
+	// path used by Bitcoin-derived params.
+	PoWFunction PoWFunction
+
+	// DiffCalcFunction, when non-nil, overrides the standard
+	// Bitcoin-style retarget algorithm used to calculate the required
+	// proof of work bits for the next block. This allows params to plug
+	// in non-standard retargeting, such as Junkcoin's height-aware
+	// Kimoto Gravity Well / DigiShield rules.
+	DiffCalcFunction DiffCalcFunction
+
+	// AuxPowActivationHeight is the height at which merged mining
+	// (AuxPoW) became valid. Blocks at or above this height may carry
+	// an auxiliary parent-chain proof of work in place of their own.
+	AuxPowActivationHeight int32
+
+	// AuxPowChainID is the chain ID merged-mining participants must use
+	// to identify this network, encoded in the high 16 bits of a
+	// merged-mined block's version field.
+	AuxPowChainID int32
+
+	// SigNetChallenge is the block-signing challenge script that every
+	// block on a BIP 325 signet must satisfy, as verified by
+	// CheckSignetBlockSignature. It is nil for non-signet networks.
+	SigNetChallenge []byte
+
+	// MinimumChainWork is the accumulated proof of work below which any
+	// header chain is rejected outright, regardless of whether its
+	// individual headers are valid. This lets a header-first sync skip
+	// detailed validation of headers it already knows cannot overtake
+	// the best known chain.
+	MinimumChainWork *big.Int
+
+	// AssumeValid is a block hash below which script and signature
+	// validation may be skipped during initial block download, since
+	// the block is already known to be valid by virtue of being an
+	// ancestor of a trusted chain tip. Proof of work is still validated
+	// on every header regardless of this setting.
+	AssumeValid chainhash.Hash
+}
+
+// ErrUnknownPrefix describes an error where a Bech32 HRP, address version
+// byte, or BIP44 coin type does not correspond to any registered network.
+var ErrUnknownPrefix = errors.New("unknown network prefix")
+
+// ErrAmbiguousPrefix describes an error where a Bech32 HRP or BIP44 coin
+// type matches more than one registered network, so the caller's lookup
+// cannot be resolved to a single Params without more context.
+var ErrAmbiguousPrefix = errors.New("network prefix matches more than one registered network")
+
+// registeredNets keeps track of the network magics that have already been
+// registered via Register.
+var registeredNets = make(map[wire.BitcoinNet]struct{})
+
+// bech32SegwitPrefixes maps a registered network's Bech32 HRP to every
+// Params that claims it, populated by Register. More than one network may
+// share an HRP (e.g. two custom signets built with the same address
+// encoding), so lookups return every match rather than silently picking
+// one.
+var bech32SegwitPrefixes = make(map[string][]*Params)
+
+// hdCoinTypes maps a registered network's BIP44 coin type to every Params
+// that claims it, populated by Register. Coin types are not guaranteed to
+// be unique across networks, so lookups return every match rather than
+// silently picking one.
+var hdCoinTypes = make(map[uint32][]*Params)
+
+// addressPrefixes maps a registered network's PubKeyHashAddrID to the
+// Params that claim it, populated by Register. More than one network may
+// share an address prefix (for example Junkcoin testnet and Bitcoin
+// testnet both use 0x6f), so lookups return every match rather than
+// silently picking one.
+var addressPrefixes = make(map[byte][]*Params)
+
+// Register registers the network parameters for a Junkcoin network. This
+// should be called once for each network a caller wishes to support and
+// typically done from an init function. It returns ErrDuplicateNet if the
+// network has already been registered.
+func Register(params *Params) error {
+	if _, ok := registeredNets[params.Net]; ok {
+		return ErrDuplicateNet
+	}
+	registeredNets[params.Net] = struct{}{}
+	bech32SegwitPrefixes[params.Bech32HRPSegwit] = append(bech32SegwitPrefixes[params.Bech32HRPSegwit], params)
+	hdCoinTypes[params.HDCoinType] = append(hdCoinTypes[params.HDCoinType], params)
+	addressPrefixes[params.PubKeyHashAddrID] = append(addressPrefixes[params.PubKeyHashAddrID], params)
+	return nil
+}
+
+// IsBech32SegwitPrefix looks up the registered network whose Bech32 HRP
+// matches prefix. The comparison is against the full HRP (the part before
+// the "1" separator), not a raw address string. It returns (nil, false)
+// both when no registered network uses prefix and when more than one
+// does, since neither case can be resolved to a single network; use
+// ParamsByAddressPrefix-style iteration if the ambiguity itself matters.
+func IsBech32SegwitPrefix(prefix string) (*Params, bool) {
+	matches := bech32SegwitPrefixes[prefix]
+	if len(matches) != 1 {
+		return nil, false
+	}
+	return matches[0], true
+}
+
+// ParamsByHDCoinType looks up the registered network using the passed
+// BIP44 coin type in its HD derivation path. It returns ErrUnknownPrefix if
+// no registered network uses that coin type, and ErrAmbiguousPrefix if
+// more than one does.
+func ParamsByHDCoinType(coinType uint32) (*Params, error) {
+	matches := hdCoinTypes[coinType]
+	switch len(matches) {
+	case 0:
+		return nil, ErrUnknownPrefix
+	case 1:
+		return matches[0], nil
+	default:
+		return nil, ErrAmbiguousPrefix
+	}
+}
+
+// ParamsByAddressPrefix returns every registered network whose
+// PubKeyHashAddrID matches prefix. Address version bytes are not
+// guaranteed to be unique across networks (e.g. Junkcoin testnet and
+// Bitcoin testnet both use 0x6f), so callers must be prepared to
+// disambiguate using other means rather than assuming a single match.
+func ParamsByAddressPrefix(prefix byte) []*Params {
+	return addressPrefixes[prefix]
+}
+
+// newHashFromStr converts the passed big-endian hex string into a
+// chainhash.Hash and will panic if there is an error. It only differs from
+// the one available in chainhash in the fact that it panics on an error so
+// it is only safe to use with hard coded values.
+func newHashFromStr(hexStr string) *chainhash.Hash {
+	hash, err := chainhash.NewHashFromStr(hexStr)
+	if err != nil {
+		panic(err)
+	}
+	return hash
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2025 Junkcoin Developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chaincfg
+
+import "testing"
+
+// TestParamsByAddressPrefixCollision verifies that an address version byte
+// shared by more than one registered network (Junkcoin testnet and
+// Junkcoin regtest both use 0x6f for PubKeyHashAddrID) is surfaced as
+// multiple matches rather than silently resolved to one.
+func TestParamsByAddressPrefixCollision(t *testing.T) {
+	matches := ParamsByAddressPrefix(JunkcoinTestNetParams.PubKeyHashAddrID)
+	if len(matches) < 2 {
+		t.Fatalf("got %d matches for PubKeyHashAddrID 0x%x, want at least 2 (testnet and regtest)",
+			len(matches), JunkcoinTestNetParams.PubKeyHashAddrID)
+	}
+
+	var sawTestNet, sawRegTest bool
+	for _, p := range matches {
+		switch p.Name {
+		case JunkcoinTestNetParams.Name:
+			sawTestNet = true
+		case JunkcoinRegressionNetParams.Name:
+			sawRegTest = true
+		}
+	}
+	if !sawTestNet || !sawRegTest {
+		t.Errorf("ParamsByAddressPrefix(0x%x) = %v, want it to include both testnet and regtest",
+			JunkcoinTestNetParams.PubKeyHashAddrID, matches)
+	}
+}
+
+// TestParamsByHDCoinTypeNoCollision regression-tests the bug where
+// JunkcoinTestNetParams and the default JunkcoinSigNetParams both claimed
+// HD coin type 11337: ParamsByHDCoinType on testnet's (unique) coin type
+// must resolve to testnet, not silently to signet.
+func TestParamsByHDCoinTypeNoCollision(t *testing.T) {
+	params, err := ParamsByHDCoinType(JunkcoinTestNetParams.HDCoinType)
+	if err != nil {
+		t.Fatalf("ParamsByHDCoinType(%d): %v", JunkcoinTestNetParams.HDCoinType, err)
+	}
+	if params.Name != JunkcoinTestNetParams.Name {
+		t.Errorf("ParamsByHDCoinType(%d) = %q, want %q",
+			JunkcoinTestNetParams.HDCoinType, params.Name, JunkcoinTestNetParams.Name)
+	}
+}
+
+// TestParamsByHDCoinTypeAmbiguous verifies that when two registered
+// networks share an HD coin type, both ParamsByHDCoinType and
+// IsBech32SegwitPrefix surface the ambiguity instead of silently returning
+// one of them. CustomSignetParams gives every signet the same coin type
+// and Bech32 HRP regardless of challenge, so two independently registered
+// custom signets collide on both by construction. registerForTest undoes
+// the registration once the test completes, so this stays idempotent
+// under repeated runs (e.g. go test -count=2) instead of permanently
+// mutating the shared package-level registry.
+func TestParamsByHDCoinTypeAmbiguous(t *testing.T) {
+	first := CustomSignetParams([]byte{0x51}, nil)
+	registerForTest(t, &first)
+	second := CustomSignetParams([]byte{0x52}, nil)
+	registerForTest(t, &second)
+
+	if _, err := ParamsByHDCoinType(first.HDCoinType); err != ErrAmbiguousPrefix {
+		t.Errorf("ParamsByHDCoinType(%d) error = %v, want ErrAmbiguousPrefix", first.HDCoinType, err)
+	}
+
+	if _, ok := IsBech32SegwitPrefix(first.Bech32HRPSegwit); ok {
+		t.Errorf("IsBech32SegwitPrefix(%q) = ok, want ambiguity to report !ok", first.Bech32HRPSegwit)
+	}
+}
+
+// registerForTest registers params and schedules its removal from every
+// package-level registry once the test completes, so a test that
+// registers throwaway networks doesn't leave the shared registry mutated
+// for subsequent test runs in the same process.
+func registerForTest(t *testing.T, params *Params) {
+	t.Helper()
+	if err := Register(params); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	t.Cleanup(func() {
+		delete(registeredNets, params.Net)
+		bech32SegwitPrefixes[params.Bech32HRPSegwit] = removeParams(bech32SegwitPrefixes[params.Bech32HRPSegwit], params)
+		hdCoinTypes[params.HDCoinType] = removeParams(hdCoinTypes[params.HDCoinType], params)
+		addressPrefixes[params.PubKeyHashAddrID] = removeParams(addressPrefixes[params.PubKeyHashAddrID], params)
+	})
+}
+
+// removeParams returns list with every pointer equal to target removed.
+func removeParams(list []*Params, target *Params) []*Params {
+	out := make([]*Params, 0, len(list))
+	for _, p := range list {
+		if p != target {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// TestParamsByHDCoinTypeUnknown verifies that a coin type no registered
+// network uses returns ErrUnknownPrefix.
+func TestParamsByHDCoinTypeUnknown(t *testing.T) {
+	if _, err := ParamsByHDCoinType(0xffffffff); err != ErrUnknownPrefix {
+		t.Errorf("ParamsByHDCoinType(0xffffffff) error = %v, want ErrUnknownPrefix", err)
+	}
+}
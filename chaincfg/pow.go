@@ -0,0 +1,202 @@
+// Copyright (c) 2025 Junkcoin Developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chaincfg
+
+import (
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"golang.org/x/crypto/scrypt"
+)
+
+// junkcoinDigishieldForkHeight is the height at which Junkcoin mainnet
+// switched from a 24-hour, Bitcoin-style retarget window to a per-block
+// Kimoto Gravity Well / DigiShield style retarget.
+const junkcoinDigishieldForkHeight = 145000
+
+// digishieldDampingFactor is the divisor DigiShield applies to the
+// difference between the actual and target per-block spacing before
+// retargeting, so a single slow or fast block nudges difficulty instead of
+// swinging it the full distance and oscillating block-to-block.
+const digishieldDampingFactor = 8
+
+// scryptN, scryptR and scryptP are the Scrypt cost parameters used by
+// Junkcoin's proof of work, matching the Litecoin/Dogecoin family it
+// descends from.
+const (
+	scryptN = 1024
+	scryptR = 1
+	scryptP = 1
+)
+
+// BlockPoWHash computes the proof of work hash for a serialized block
+// header at the given height, honoring params.PoWFunction when it is set
+// and falling back to the standard double-SHA256 hash used by Bitcoin
+// otherwise. This is the single entry point validation code should call so
+// that plugging in a non-standard PoW algorithm, such as Junkcoin's
+// Scrypt, doesn't require forking the caller.
+func BlockPoWHash(headerBytes []byte, height int32, params *Params) chainhash.Hash {
+	if params.PoWFunction != nil {
+		return params.PoWFunction(headerBytes, height)
+	}
+	return chainhash.DoubleHashH(headerBytes)
+}
+
+// CalcNextRequiredBits computes the required proof of work bits for the
+// block that follows the passed headers, honoring params.DiffCalcFunction
+// when it is set and falling back to the standard Bitcoin retarget
+// formula otherwise: the time between the first and the last passed header
+// is compared against TargetTimespan, whatever the number of headers
+// passed. This is the single
+// entry point validation code should call so that plugging in a
+// non-standard retarget, such as Junkcoin's height-aware rules, doesn't
+// require forking the caller.
+func CalcNextRequiredBits(headers []wire.BlockHeader, height int32, params *Params) (uint32, error) {
+	if params.DiffCalcFunction != nil {
+		return params.DiffCalcFunction(headers, height, params)
+	}
+
+	if len(headers) == 0 {
+		return 0, errors.New("chaincfg: CalcNextRequiredBits requires at least one header")
+	}
+	first := headers[0]
+	last := headers[len(headers)-1]
+	actualTimespan := last.Timestamp.Sub(first.Timestamp)
+	return calcNextBits(last.Bits, actualTimespan, params.TargetTimespan, params)
+}
+
+// ScryptPoWHash computes the Scrypt proof of work hash of a serialized
+// block header. It is used as the PoWFunction for JunkcoinMainNetParams and
+// JunkcoinTestNetParams. The height argument is accepted so it satisfies
+// the PoWFunction signature but is unused since Scrypt hashing does not
+// depend on block height.
+func ScryptPoWHash(headerBytes []byte, height int32) chainhash.Hash {
+	digest, err := scrypt.Key(headerBytes, headerBytes, scryptN, scryptR, scryptP, chainhash.HashSize)
+	if err != nil {
+		// scrypt.Key only returns an error for invalid cost
+		// parameters, which are fixed constants above, so this can
+		// never happen in practice.
+		panic(err)
+	}
+
+	var hash chainhash.Hash
+	copy(hash[:], digest)
+	return hash
+}
+
+// JunkcoinDiffCalc computes the required proof of work bits for the block
+// that follows the passed headers. Prior to junkcoinDigishieldForkHeight it
+// reproduces the original 24-hour Bitcoin-style retarget over the full
+// window of headers. From that height onward it retargets every block
+// using DigiShield: the single-block actual spacing is damped by
+// digishieldDampingFactor toward the target spacing before being clamped
+// to a -25%/+50% band, which avoids the oscillation a bare single-block
+// retarget would produce.
+func JunkcoinDiffCalc(headers []wire.BlockHeader, height int32, params *Params) (uint32, error) {
+	if len(headers) == 0 {
+		return 0, errors.New("chaincfg: JunkcoinDiffCalc requires at least one header")
+	}
+
+	last := headers[len(headers)-1]
+	if height < junkcoinDigishieldForkHeight {
+		first := headers[0]
+		actualTimespan := last.Timestamp.Sub(first.Timestamp)
+		return calcNextBits(last.Bits, actualTimespan, params.TargetTimespan, params)
+	}
+
+	if len(headers) < 2 {
+		return last.Bits, nil
+	}
+	prev := headers[len(headers)-2]
+	spacing := last.Timestamp.Sub(prev.Timestamp)
+	target := params.TargetTimePerBlock
+
+	dampedSpacing := target + (spacing-target)/digishieldDampingFactor
+	minSpacing := target - target/4
+	maxSpacing := target + target/2
+	if dampedSpacing < minSpacing {
+		dampedSpacing = minSpacing
+	} else if dampedSpacing > maxSpacing {
+		dampedSpacing = maxSpacing
+	}
+
+	return calcNextBits(last.Bits, dampedSpacing, target, params)
+}
+
+// calcNextBits applies the standard Bitcoin retarget formula -
+// newTarget = oldTarget * actualTimespan / targetTimespan - clamped to the
+// params' adjustment factor and PowLimit, to the passed actual timespan.
+func calcNextBits(bits uint32, actualTimespan, targetTimespan time.Duration, params *Params) (uint32, error) {
+	minTimespan := targetTimespan / time.Duration(params.RetargetAdjustmentFactor)
+	maxTimespan := targetTimespan * time.Duration(params.RetargetAdjustmentFactor)
+	if actualTimespan < minTimespan {
+		actualTimespan = minTimespan
+	} else if actualTimespan > maxTimespan {
+		actualTimespan = maxTimespan
+	}
+
+	oldTarget := compactToBig(bits)
+	newTarget := new(big.Int).Mul(oldTarget, big.NewInt(int64(actualTimespan)))
+	newTarget.Div(newTarget, big.NewInt(int64(targetTimespan)))
+	if newTarget.Cmp(params.PowLimit) > 0 {
+		newTarget.Set(params.PowLimit)
+	}
+	return bigToCompact(newTarget), nil
+}
+
+// compactToBig converts a whole number N from its compact representation,
+// an unsigned 32-bit number, to a big.Int. This logic is mirrored from the Bitcoin reference
+// implementation's nBits encoding.
+func compactToBig(compact uint32) *big.Int {
+	mantissa := compact & 0x007fffff
+	exponent := uint(compact >> 24)
+
+	var bn *big.Int
+	if exponent <= 3 {
+		mantissa >>= 8 * (3 - exponent)
+		bn = big.NewInt(int64(mantissa))
+	} else {
+		bn = big.NewInt(int64(mantissa))
+		bn.Lsh(bn, 8*(exponent-3))
+	}
+
+	if compact&0x00800000 != 0 {
+		bn = bn.Neg(bn)
+	}
+	return bn
+}
+
+// bigToCompact converts a whole number N to a compact representation using
+// an unsigned 32-bit number, mirroring the Bitcoin reference
+// implementation's nBits encoding.
+func bigToCompact(n *big.Int) uint32 {
+	if n.Sign() == 0 {
+		return 0
+	}
+
+	var mantissa uint32
+	exponent := uint(len(n.Bytes()))
+	if exponent <= 3 {
+		mantissa = uint32(n.Bits()[0])
+		mantissa <<= 8 * (3 - exponent)
+	} else {
+		tn := new(big.Int).Set(n)
+		mantissa = uint32(tn.Rsh(tn, 8*(exponent-3)).Bits()[0])
+	}
+
+	if mantissa&0x00800000 != 0 {
+		mantissa >>= 8
+		exponent++
+	}
+
+	compact := uint32(exponent<<24) | mantissa
+	if n.Sign() < 0 {
+		compact |= 0x00800000
+	}
+	return compact
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2025 Junkcoin Developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chaincfg
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// TestCompactBigRoundTrip verifies bigToCompact(compactToBig(x)) == x for a
+// handful of representative compact-bits encodings, including the Junkcoin
+// mainnet PowLimit and a couple of real-world-shaped difficulties.
+func TestCompactBigRoundTrip(t *testing.T) {
+	tests := []uint32{
+		0x1d00ffff,
+		0x1e0ffff0,
+		0x1c123456,
+		0x207fffff,
+		0,
+	}
+
+	for _, compact := range tests {
+		n := compactToBig(compact)
+		got := bigToCompact(n)
+		if got != compact {
+			t.Errorf("bigToCompact(compactToBig(0x%08x)) = 0x%08x, want 0x%08x", compact, got, compact)
+		}
+	}
+}
+
+// TestJunkcoinDiffCalcPreForkBoundary verifies the height check around
+// junkcoinDigishieldForkHeight actually switches retarget strategy: just
+// before the fork the full-window timespan is used, at/after it only the
+// most recent two headers are.
+func TestJunkcoinDiffCalcPreForkBoundary(t *testing.T) {
+	params := JunkcoinTestNetParams
+
+	// A difficulty well under PowLimit, so a retarget that loosens the
+	// target isn't masked by clamping to PowLimit.
+	const bits = 0x1d00ffff
+
+	base := time.Unix(1231006505, 0)
+	headers := []wire.BlockHeader{
+		{Bits: bits, Timestamp: base},
+		{Bits: bits, Timestamp: base.Add(params.TargetTimespan / 2)},
+		{Bits: bits, Timestamp: base.Add(params.TargetTimespan)},
+	}
+
+	preForkBits, err := JunkcoinDiffCalc(headers, junkcoinDigishieldForkHeight-1, &params)
+	if err != nil {
+		t.Fatalf("JunkcoinDiffCalc (pre-fork): %v", err)
+	}
+
+	postForkBits, err := JunkcoinDiffCalc(headers, junkcoinDigishieldForkHeight, &params)
+	if err != nil {
+		t.Fatalf("JunkcoinDiffCalc (post-fork): %v", err)
+	}
+
+	// Pre-fork uses the full [0]..[len-1] span (one full TargetTimespan,
+	// so it reproduces the same bits); post-fork only looks at the last
+	// two headers (half of TargetTimespan worth of actual spacing
+	// against a TargetTimePerBlock target), so the two must differ.
+	if preForkBits == postForkBits {
+		t.Errorf("pre-fork and post-fork retarget produced the same bits (0x%08x); expected the fork to change the retarget window", preForkBits)
+	}
+}
+
+// TestJunkcoinDiffCalcDampingAvoidsFullSwing verifies the post-fork
+// DigiShield damping: a single block that arrives far faster than target
+// should only nudge difficulty up, not swing it the full distance a bare
+// (undamped) retarget would.
+func TestJunkcoinDiffCalcDampingAvoidsFullSwing(t *testing.T) {
+	params := JunkcoinTestNetParams
+	base := time.Unix(1401292357, 0)
+
+	// A difficulty under PowLimit, so neither retarget is clamped to
+	// PowLimit and the two results can be told apart.
+	const bits = 0x1d00ffff
+
+	// Block arrived in a tenth of the target spacing.
+	fastSpacing := params.TargetTimePerBlock / 10
+	headers := []wire.BlockHeader{
+		{Bits: bits, Timestamp: base},
+		{Bits: bits, Timestamp: base.Add(fastSpacing)},
+	}
+
+	gotBits, err := JunkcoinDiffCalc(headers, junkcoinDigishieldForkHeight, &params)
+	if err != nil {
+		t.Fatalf("JunkcoinDiffCalc: %v", err)
+	}
+
+	undampedBits, err := calcNextBits(bits, fastSpacing, params.TargetTimePerBlock, &params)
+	if err != nil {
+		t.Fatalf("calcNextBits: %v", err)
+	}
+
+	got := compactToBig(gotBits)
+	undamped := compactToBig(undampedBits)
+	limit := compactToBig(params.PowLimitBits)
+
+	// A looser (larger) target than the undamped calculation means
+	// damping pulled the adjustment back toward the target spacing
+	// rather than applying the full swing.
+	if got.Cmp(undamped) <= 0 {
+		t.Errorf("damped target %s is not looser than undamped target %s for a block 10x faster than target", got, undamped)
+	}
+	if got.Cmp(limit) > 0 {
+		t.Errorf("damped target %s exceeds PowLimit %s", got, limit)
+	}
+}
+
+// TestJunkcoinDiffCalcNoHeaders verifies JunkcoinDiffCalc rejects an empty
+// header slice instead of panicking.
+func TestJunkcoinDiffCalcNoHeaders(t *testing.T) {
+	params := JunkcoinTestNetParams
+	if _, err := JunkcoinDiffCalc(nil, 0, &params); err == nil {
+		t.Error("JunkcoinDiffCalc(nil, ...) returned nil error, want an error")
+	}
+}
+
+// TestCalcNextRequiredBitsFallback verifies CalcNextRequiredBits falls
+// back to the standard Bitcoin-style retarget when DiffCalcFunction is
+// nil, rather than silently doing nothing.
+func TestCalcNextRequiredBitsFallback(t *testing.T) {
+	params := JunkcoinTestNetParams
+	params.DiffCalcFunction = nil
+
+	// A difficulty under PowLimit, so an unchanged timespan is not
+	// masked by clamping to PowLimit.
+	const startBits = 0x1d00ffff
+
+	base := time.Unix(1231006505, 0)
+	headers := []wire.BlockHeader{
+		{Bits: startBits, Timestamp: base},
+		{Bits: startBits, Timestamp: base.Add(params.TargetTimespan)},
+	}
+
+	bits, err := CalcNextRequiredBits(headers, 1, &params)
+	if err != nil {
+		t.Fatalf("CalcNextRequiredBits: %v", err)
+	}
+	if bits != startBits {
+		t.Errorf("CalcNextRequiredBits with an unchanged timespan = 0x%08x, want unchanged 0x%08x", bits, uint32(startBits))
+	}
+}
+
+// TestBlockPoWHashFallback verifies BlockPoWHash falls back to
+// double-SHA256 when PoWFunction is nil.
+func TestBlockPoWHashFallback(t *testing.T) {
+	params := JunkcoinTestNetParams
+	params.PoWFunction = nil
+
+	data := []byte("junkcoin")
+	got := BlockPoWHash(data, 0, &params)
+	want := chainhash.DoubleHashH(data)
+	if got != want {
+		t.Errorf("BlockPoWHash fallback = %s, want %s", got, want)
+	}
+}
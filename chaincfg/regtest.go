@@ -0,0 +1,114 @@
+// Copyright (c) 2025 Junkcoin Developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chaincfg
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// junkcoinRegTestPowLimit is the highest proof of work value a Junkcoin
+// regression test network block can have. It is the value 2^255 - 1.
+var junkcoinRegTestPowLimit = new(big.Int).Sub(new(big.Int).Lsh(bigOne, 255), bigOne)
+
+// junkcoinRegTestGenesisMerkleRoot is the hash of the first transaction in
+// the genesis block for the regression test network.
+var junkcoinRegTestGenesisMerkleRoot = mustParseHash("4a5e1e4baab89f3a32518a88c31bc87f618f76673e2cc77ab2127b7afdeda33b")
+
+// junkcoinRegTestGenesisBlock defines the genesis block of the block chain
+// which serves as the public transaction ledger for the regression test
+// network.
+var junkcoinRegTestGenesisBlock = wire.MsgBlock{
+	Header: wire.BlockHeader{
+		Version:    1,
+		PrevBlock:  chainhash.Hash{}, // All zeroes
+		MerkleRoot: junkcoinRegTestGenesisMerkleRoot,
+		Timestamp:  time.Unix(1401292357, 0), // TODO: Update with actual timestamp
+		Bits:       0x207fffff,
+		Nonce:      0,
+	},
+	Transactions: []*wire.MsgTx{&genesisCoinbaseTx},
+}
+
+// junkcoinRegTestGenesisHash is the hash of the regression test network
+// genesis block.
+var junkcoinRegTestGenesisHash = junkcoinRegTestGenesisBlock.BlockHash()
+
+// JunkcoinRegressionNetParams defines the network parameters for the
+// Junkcoin regression test network. It is designed for deterministic,
+// instantly-mineable local testing and is not a public network.
+var JunkcoinRegressionNetParams = Params{
+	Name:        "junkcoin-regtest",
+	Net:         0x6a756e6b + 3, // "junk" + 3 in ASCII as a 4-byte uint32
+	DefaultPort: "19775",
+	DNSSeeds:    []DNSSeed{}, // No seeds; regtest peers are added manually
+
+	// Chain parameters
+	GenesisBlock:             &junkcoinRegTestGenesisBlock,
+	GenesisHash:              &junkcoinRegTestGenesisHash,
+	PowLimit:                 junkcoinRegTestPowLimit,
+	PowLimitBits:             0x207fffff,
+	BIP0034Height:            0,
+	BIP0065Height:            0,
+	BIP0066Height:            0,
+	CoinbaseMaturity:         100,
+	SubsidyReductionInterval: 150,
+	TargetTimespan:           time.Hour * 24,
+	TargetTimePerBlock:       time.Minute * 1,
+	RetargetAdjustmentFactor: 4,
+	ReduceMinDifficulty:      true,
+	MinDiffReductionTime:     time.Minute * 2,
+	GenerateSupported:        true,
+
+	// No checkpoints for regtest.
+	Checkpoints: nil,
+
+	RuleChangeActivationThreshold: 108, // 75% of MinerConfirmationWindow
+	MinerConfirmationWindow:       144,
+
+	// Mempool parameters
+	RelayNonStdTxs: true,
+
+	// Human-readable part for Bech32 encoded segwit addresses, as
+	// defined in BIP 173.
+	Bech32HRPSegwit: "jcrt",
+
+	// Address encoding magics (shared with testnet so regtest wallets
+	// can reuse testnet-style addresses)
+	PubKeyHashAddrID:        0x6f,
+	ScriptHashAddrID:        0xc4,
+	PrivateKeyID:            0xef,
+	WitnessPubKeyHashAddrID: 0x03,
+	WitnessScriptHashAddrID: 0x28,
+
+	// BIP32 hierarchical deterministic extended key magics
+	HDPrivateKeyID: [4]byte{0x04, 0x35, 0x83, 0x94}, // starts with tprv
+	HDPublicKeyID:  [4]byte{0x04, 0x35, 0x87, 0xcf}, // starts with tpub
+
+	// BIP44 coin type used in the hierarchical deterministic path for
+	// address generation. 1 is the BIP-44 test-vector coin type;
+	// JunkcoinTestNetParams and the signets use coin types of their own.
+	HDCoinType: 1,
+
+	// Regtest still uses Scrypt proof of work so block templates built
+	// against it exercise the same validation path as mainnet/testnet.
+	PoWFunction:      ScryptPoWHash,
+	DiffCalcFunction: JunkcoinDiffCalc,
+}
+
+// IsRegressionNet reports whether params identifies the Junkcoin regression
+// test network.
+func IsRegressionNet(params *Params) bool {
+	return params.Net == JunkcoinRegressionNetParams.Net
+}
+
+func init() {
+	if err := Register(&JunkcoinRegressionNetParams); err != nil {
+		panic(err)
+	}
+}
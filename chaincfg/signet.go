@@ -0,0 +1,288 @@
+// Copyright (c) 2025 Junkcoin Developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chaincfg
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// signetMagic is the magic value, following BIP 325, that prefixes the
+// OP_RETURN payload carrying a signet block's commitment signature.
+var signetMagic = []byte{0xec, 0xc7, 0xda, 0xa2}
+
+// JunkcoinSigNetParams defines the network parameters for the default
+// Junkcoin signet, following the BIP 325 signet pattern. Blocks on signet
+// must additionally satisfy CheckSignetBlockSignature against
+// SigNetChallenge.
+var JunkcoinSigNetParams = CustomSignetParams(defaultSigNetChallenge, SigNetSeeds)
+
+// defaultSigNetChallenge is the default block-signing challenge script for
+// the public Junkcoin signet.
+var defaultSigNetChallenge = []byte{
+	0x51, 0x21, 0x03, 0xad, 0x5e, 0x0e, 0xdb, 0x3c, 0x3f, 0x96, 0x7f,
+	0xba, 0x95, 0x5e, 0x3e, 0xa9, 0xa6, 0x9d, 0xac, 0x9d, 0x05, 0x1f,
+	0x3c, 0x5a, 0x1f, 0xe8, 0x1d, 0x9a, 0x9b, 0x02, 0x3d, 0x40, 0x39,
+	0xea, 0x07, 0x51, 0xae,
+}
+
+// SigNetSeeds defines a list of DNS seeds for the default Junkcoin signet.
+var SigNetSeeds = []DNSSeed{
+	{"signet.junk-coin.com", true},
+}
+
+func init() {
+	if err := Register(&JunkcoinSigNetParams); err != nil {
+		panic(err)
+	}
+}
+
+// CustomSignetParams builds Junkcoin signet parameters for the given
+// block-signing challenge script and DNS seeds. This allows developers to
+// spin up private Junkcoin signets, e.g. for wallet integration testing,
+// mirroring JunkcoinMainNetParams/JunkcoinTestNetParams. Net is derived
+// from the challenge, the same way Bitcoin Core derives its default
+// signet's network magic, so distinct challenges produce distinct,
+// independently registerable networks instead of colliding with each
+// other or with the default JunkcoinSigNetParams.
+func CustomSignetParams(challenge []byte, seeds []DNSSeed) Params {
+	return Params{
+		Name:        "junkcoin-signet",
+		Net:         signetNet(challenge),
+		DefaultPort: "29771",
+		DNSSeeds:    seeds,
+
+		// Chain parameters
+		GenesisBlock:             &junkcoinTestNetGenesisBlock,
+		GenesisHash:              &junkcoinTestNetGenesisHash,
+		PowLimit:                 junkcoinTestNetPowLimit,
+		PowLimitBits:             0x1e0ffff0,
+		BIP0034Height:            0,
+		BIP0065Height:            0,
+		BIP0066Height:            0,
+		CoinbaseMaturity:         70,
+		SubsidyReductionInterval: 518400,
+		TargetTimespan:           time.Hour * 24,
+		TargetTimePerBlock:       time.Minute * 1,
+		RetargetAdjustmentFactor: 4,
+		ReduceMinDifficulty:      true,
+		MinDiffReductionTime:     time.Minute * 2,
+		GenerateSupported:        false,
+
+		// Signet blocks are additionally required to satisfy
+		// CheckSignetBlockSignature against SigNetChallenge.
+		SigNetChallenge: challenge,
+
+		// Signet has no checkpoints; its integrity is guaranteed by
+		// the signing challenge instead.
+		Checkpoints: nil,
+
+		RuleChangeActivationThreshold: 1512,
+		MinerConfirmationWindow:       2016,
+
+		RelayNonStdTxs: true,
+
+		Bech32HRPSegwit: "jcs",
+
+		PubKeyHashAddrID:        0x6f,
+		ScriptHashAddrID:        0xc4,
+		PrivateKeyID:            0xef,
+		WitnessPubKeyHashAddrID: 0x03,
+		WitnessScriptHashAddrID: 0x28,
+
+		HDPrivateKeyID: [4]byte{0x04, 0x35, 0x83, 0x94},
+		HDPublicKeyID:  [4]byte{0x04, 0x35, 0x87, 0xcf},
+
+		// A distinct coin type from JunkcoinTestNetParams so
+		// ParamsByHDCoinType can tell testnet and signet wallets
+		// apart instead of the two colliding.
+		HDCoinType: 11338,
+
+		PoWFunction:      ScryptPoWHash,
+		DiffCalcFunction: JunkcoinDiffCalc,
+	}
+}
+
+// signetNet derives a network magic from a signet challenge so that every
+// distinct challenge maps to a distinct, deterministic Net, mirroring how
+// Bitcoin Core computes its default signet's message start bytes from the
+// challenge script.
+func signetNet(challenge []byte) wire.BitcoinNet {
+	hash := chainhash.DoubleHashB(challenge)
+	return wire.BitcoinNet(binary.LittleEndian.Uint32(hash[:4]))
+}
+
+// calcMerkleRoot computes the merkle root of txns using the standard
+// Bitcoin pairwise double-SHA256 tree, duplicating the final hash at each
+// level that has an odd number of nodes.
+func calcMerkleRoot(txns []*wire.MsgTx) chainhash.Hash {
+	if len(txns) == 0 {
+		return chainhash.Hash{}
+	}
+
+	level := make([]chainhash.Hash, len(txns))
+	for i, tx := range txns {
+		level[i] = tx.TxHash()
+	}
+
+	for len(level) > 1 {
+		if len(level)%2 != 0 {
+			level = append(level, level[len(level)-1])
+		}
+
+		next := make([]chainhash.Hash, len(level)/2)
+		for i := range next {
+			var buf [chainhash.HashSize * 2]byte
+			copy(buf[:chainhash.HashSize], level[2*i][:])
+			copy(buf[chainhash.HashSize:], level[2*i+1][:])
+			next[i] = chainhash.DoubleHashH(buf[:])
+		}
+		level = next
+	}
+
+	return level[0]
+}
+
+// modifiedBlockHash computes the block hash used for signet signature
+// verification: the standard block header hash, with the merkle root
+// recomputed after the signet magic and everything following it are cut
+// from the coinbase output at sigScriptPos. Witness commitment data ahead
+// of the magic in that output is left in place; it is not stripped.
+func modifiedBlockHash(block *wire.MsgBlock, sigScriptPos int) (chainhash.Hash, error) {
+	header := block.Header
+
+	coinbase := block.Transactions[0].Copy()
+	txOut := coinbase.TxOut[sigScriptPos]
+	payload := txOut.PkScript
+	idx := bytes.Index(payload, signetMagic)
+	if idx == -1 {
+		return chainhash.Hash{}, errors.New("chaincfg: signet commitment magic not found in coinbase")
+	}
+	// Strip the magic and everything after it (the signature itself),
+	// leaving any witness commitment data that preceded it intact.
+	coinbase.TxOut[sigScriptPos].PkScript = payload[:idx]
+
+	header.MerkleRoot = calcMerkleRoot(append([]*wire.MsgTx{coinbase}, block.Transactions[1:]...))
+
+	var buf bytes.Buffer
+	if err := header.Serialize(&buf); err != nil {
+		return chainhash.Hash{}, err
+	}
+	return chainhash.DoubleHashH(buf.Bytes()), nil
+}
+
+// CheckSignetBlockSignature validates the signet commitment carried by
+// block against params.SigNetChallenge. It locates the commitment in the
+// coinbase's OP_RETURN payload following the BIP 325 0xecc7daa2 magic,
+// reconstructs the modified block hash used for signing, and verifies the
+// embedded signature satisfies the challenge script.
+func CheckSignetBlockSignature(block *wire.MsgBlock, params *Params) error {
+	if len(params.SigNetChallenge) == 0 {
+		return errors.New("chaincfg: params do not define a signet challenge")
+	}
+	if len(block.Transactions) == 0 {
+		return errors.New("chaincfg: block has no coinbase transaction")
+	}
+
+	coinbase := block.Transactions[0]
+	sigScriptPos := -1
+	var signature []byte
+	for i, out := range coinbase.TxOut {
+		idx := bytes.Index(out.PkScript, signetMagic)
+		if idx == -1 {
+			continue
+		}
+		sigScriptPos = i
+		signature = out.PkScript[idx+len(signetMagic):]
+		break
+	}
+	if sigScriptPos == -1 {
+		return errors.New("chaincfg: no signet commitment found in coinbase")
+	}
+
+	modifiedHash, err := modifiedBlockHash(block, sigScriptPos)
+	if err != nil {
+		return err
+	}
+
+	witness, err := deserializeSignetWitness(signature)
+	if err != nil {
+		return err
+	}
+
+	return verifySignetChallenge(modifiedHash, witness, params.SigNetChallenge)
+}
+
+// deserializeSignetWitness parses the signet solution (a serialized witness
+// stack, per BIP 325) carried after the magic in the coinbase commitment.
+func deserializeSignetWitness(data []byte) ([][]byte, error) {
+	r := bytes.NewReader(data)
+	count, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	witness := make([][]byte, count)
+	for i := range witness {
+		length, err := wire.ReadVarInt(r, 0)
+		if err != nil {
+			return nil, err
+		}
+		item := make([]byte, length)
+		if _, err := io.ReadFull(r, item); err != nil {
+			return nil, err
+		}
+		witness[i] = item
+	}
+	return witness, nil
+}
+
+// verifySignetChallenge validates the signet solution against the
+// challenge script, following the BIP 325 to_spend/to_sign transaction
+// construction so the existing script interpreter can be reused rather
+// than writing a bespoke verifier.
+func verifySignetChallenge(modifiedHash chainhash.Hash, witness [][]byte, challenge []byte) error {
+	spendScriptSig, err := txscript.NewScriptBuilder().
+		AddOp(txscript.OP_0).
+		AddData(modifiedHash[:]).
+		Script()
+	if err != nil {
+		return err
+	}
+
+	toSpend := wire.NewMsgTx(0)
+	toSpend.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Index: 0xffffffff},
+		SignatureScript:  spendScriptSig,
+	})
+	toSpend.AddTxOut(&wire.TxOut{Value: 0, PkScript: challenge})
+
+	toSign := wire.NewMsgTx(0)
+	txIn := &wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Hash: toSpend.TxHash(), Index: 0},
+	}
+	txIn.Witness = witness
+	toSign.AddTxIn(txIn)
+	toSign.AddTxOut(&wire.TxOut{Value: 0, PkScript: []byte{txscript.OP_RETURN}})
+
+	engine, err := txscript.NewEngine(
+		challenge, toSign, 0, txscript.StandardVerifyFlags, nil, nil, 0,
+	)
+	if err != nil {
+		return err
+	}
+	if err := engine.Execute(); err != nil {
+		return err
+	}
+
+	return nil
+}